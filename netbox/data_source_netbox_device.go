@@ -0,0 +1,177 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDevice() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxDeviceRead,
+		Description: `Use this data source to get information about a device that already exists in NetBox, for example to reference a device that was created outside of this Terraform module without hardcoding its numeric ID.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"site_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"role_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"serial": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set:      schema.HashString,
+			},
+			"device_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"location_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_ipv4": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"primary_ipv6": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rack_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	params := dcim.NewDcimDevicesListParams()
+
+	if name, ok := d.GetOk("name"); ok {
+		nameStr := name.(string)
+		params.SetName(&nameStr)
+	}
+
+	if siteID, ok := d.GetOk("site_id"); ok {
+		siteIDStr := strconv.Itoa(siteID.(int))
+		params.SetSiteID(&siteIDStr)
+	}
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		tenantIDStr := strconv.Itoa(tenantID.(int))
+		params.SetTenantID(&tenantIDStr)
+	}
+
+	if roleID, ok := d.GetOk("role_id"); ok {
+		roleIDStr := strconv.Itoa(roleID.(int))
+		params.SetRoleID(&roleIDStr)
+	}
+
+	if serial, ok := d.GetOk("serial"); ok {
+		serialStr := serial.(string)
+		params.SetSerial(&serialStr)
+	}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		tagList, _ := getNestedTagListFromResourceDataSet(api, tags)
+		tagNames := make([]string, len(tagList))
+		for i, tag := range tagList {
+			tagNames[i] = *tag.Slug
+		}
+		params.SetTag(tagNames)
+	}
+
+	res, err := api.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if count := *res.GetPayload().Count; count != 1 {
+		return diag.Errorf("expected one netbox_device to match the given filters, got %d", count)
+	}
+
+	device := res.GetPayload().Results[0]
+
+	d.SetId(strconv.FormatInt(device.ID, 10))
+	d.Set("name", device.Name)
+
+	if device.DeviceType != nil {
+		d.Set("device_type_id", device.DeviceType.ID)
+	}
+
+	if device.Location != nil {
+		d.Set("location_id", device.Location.ID)
+	}
+
+	if device.PrimaryIp4 != nil {
+		d.Set("primary_ipv4", device.PrimaryIp4.ID)
+	}
+
+	if device.PrimaryIp6 != nil {
+		d.Set("primary_ipv6", device.PrimaryIp6.ID)
+	}
+
+	if device.Platform != nil {
+		d.Set("platform_id", device.Platform.ID)
+	}
+
+	if device.Cluster != nil {
+		d.Set("cluster_id", device.Cluster.ID)
+	}
+
+	if device.Rack != nil {
+		d.Set("rack_id", device.Rack.ID)
+	}
+
+	if device.Status != nil {
+		d.Set("status", device.Status.Value)
+	}
+
+	d.Set("comments", device.Comments)
+
+	d.Set("tags", getTagListFromNestedTagList(device.Tags))
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceDataSource_basic(t *testing.T) {
+	testSlug := "devicedsbasic"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceDataSourceConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_device.test", "id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_device.test", "device_type_id", "netbox_device.test", "device_type_id"),
+					resource.TestCheckResourceAttr("data.netbox_device.test", "name", testName),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetboxDeviceDataSourceConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name            = "%[1]s"
+  device_type_id  = netbox_device_type.test.id
+  role_id         = netbox_device_role.test.id
+  site_id         = netbox_site.test.id
+}
+
+data "netbox_device" "test" {
+  name = netbox_device.test.name
+}
+`, testName)
+}
@@ -0,0 +1,76 @@
+package netbox
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// Every nullable field on the generated go-netbox write models (platform,
+// cluster, rack, position, mtu, untagged_vlan, primary_ip4, primary_ip6,
+// ...) is tagged `json:"...,omitempty"`. That's correct for "don't touch
+// this field", but it means a Go nil can never be serialized as a JSON
+// `null`: encoding/json just drops the key, and NetBox's partial-update
+// serializer leaves the field at whatever it was before. There is no way to
+// ask a typed DcimXxxPartialUpdateParams to clear such a field.
+//
+// patchNulls works around this by submitting a PATCH directly through the
+// client's runtime.ClientTransport with a hand-built map[string]interface{}
+// body, bypassing the generated model entirely for the fields being
+// cleared. A nil value in fields marshals to JSON `null`, which NetBox
+// honors. Callers should still do their normal typed PartialUpdate/Update
+// call for every field that's being set rather than cleared; this is only
+// for the fields a Read showed as present and the config now wants gone.
+func patchNulls(api *client.NetBoxAPI, pathPattern string, id int64, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	op := &runtime.ClientOperation{
+		ID:                 "netbox_patch_nulls",
+		Method:             "PATCH",
+		PathPattern:        pathPattern,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             &nullPatchParams{id: id, body: fields},
+		Reader:             nullPatchReader{pathPattern: pathPattern},
+		Context:            nil,
+	}
+
+	_, err := api.Transport.Submit(op)
+	return err
+}
+
+// nullPatchParams writes body verbatim as the request's JSON payload,
+// instead of marshaling through a generated model's omitempty tags.
+type nullPatchParams struct {
+	id   int64
+	body map[string]interface{}
+}
+
+func (p *nullPatchParams) WriteToRequest(r runtime.ClientRequest, _ strfmt.Registry) error {
+	if err := r.SetPathParam("id", strconv.FormatInt(p.id, 10)); err != nil {
+		return err
+	}
+	return r.SetBodyParam(p.body)
+}
+
+// nullPatchReader only cares whether the PATCH succeeded; the caller's
+// usual typed Read picks up the resulting state afterwards.
+type nullPatchReader struct {
+	pathPattern string
+}
+
+func (r nullPatchReader) ReadResponse(resp runtime.ClientResponse, _ runtime.Consumer) (interface{}, error) {
+	defer resp.Body().Close()
+	if resp.Code() >= 200 && resp.Code() < 300 {
+		return nil, nil
+	}
+	body, _ := io.ReadAll(resp.Body())
+	return nil, fmt.Errorf("netbox: PATCH %s: unexpected status %d: %s", r.pathPattern, resp.Code(), body)
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns the schema.Provider for this plugin.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"server_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_SERVER_URL", nil),
+			},
+			"api_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN", nil),
+			},
+			"allow_insecure_https": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_ALLOW_INSECURE_HTTPS", false),
+			},
+			"use_read_cache": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_USE_READ_CACHE", false),
+				Description: "Opt in to an in-process, read-through cache in front of device/interface/cable Reads. Speeds up `terraform plan` on large inventories at the cost of possibly serving state that's a few seconds stale within a single plan/apply.",
+			},
+			"read_cache_batch_size": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_READ_CACHE_BATCH_SIZE", 50),
+				Description: "Maximum number of IDs per `id__in`-filtered List call issued to warm the read cache. Only used when use_read_cache is true.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"netbox_device":            resourceNetboxDevice(),
+			"netbox_device_interface":  resourceNetboxDeviceInterface(),
+			"netbox_cable":             resourceNetboxCable(),
+			"netbox_device_primary_ip": resourceNetboxDevicePrimaryIP(),
+			"netbox_platform":          resourceNetboxPlatform(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"netbox_device": dataSourceNetboxDevice(),
+		},
+
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	serverURL, err := url.Parse(d.Get("server_url").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	httpClient := &http.Client{}
+	if d.Get("allow_insecure_https").(bool) {
+		insecureTransport, err := httptransport.TLSTransport(httptransport.TLSClientOptions{InsecureSkipVerify: true})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		httpClient.Transport = insecureTransport
+	}
+
+	transport := httptransport.NewWithClient(serverURL.Host, client.DefaultBasePath, []string{serverURL.Scheme}, httpClient)
+	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", "Token "+d.Get("api_token").(string))
+
+	netboxClient := client.New(transport, nil)
+
+	// Keyed by netboxClient so two aliased `netbox` provider blocks pointed
+	// at different servers (or configured with different cache settings)
+	// never share read-cache state or configuration.
+	ConfigureReadCache(netboxClient, d.Get("use_read_cache").(bool), d.Get("read_cache_batch_size").(int))
+
+	return netboxClient, nil
+}
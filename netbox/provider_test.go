@@ -0,0 +1,32 @@
+package netbox
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testAccProvider *schema.Provider
+var testAccProviders map[string]func() (*schema.Provider, error)
+
+func init() {
+	testAccProvider = Provider()
+	testAccProviders = map[string]func() (*schema.Provider, error){
+		"netbox": func() (*schema.Provider, error) {
+			return testAccProvider, nil
+		},
+	}
+}
+
+// testAccPreCheck verifies the environment is set up for acceptance tests
+// against a real NetBox instance. Acceptance tests themselves are gated
+// behind TF_ACC by the SDK's resource.Test/resource.ParallelTest helpers.
+func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("NETBOX_SERVER_URL"); v == "" {
+		t.Fatal("NETBOX_SERVER_URL must be set for acceptance tests")
+	}
+	if v := os.Getenv("NETBOX_API_TOKEN"); v == "" {
+		t.Fatal("NETBOX_API_TOKEN must be set for acceptance tests")
+	}
+}
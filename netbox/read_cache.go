@@ -0,0 +1,349 @@
+package netbox
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+)
+
+// defaultReadCacheBatchSize is used for any api instance that hasn't been
+// through ConfigureReadCache yet (e.g. in tests that build a readCache
+// directly).
+const defaultReadCacheBatchSize = 50
+
+// readCacheSettings holds the provider's use_read_cache and
+// read_cache_batch_size attributes for a single *client.NetBoxAPI instance.
+// Settings are looked up by api instance, not stored in package globals, so
+// two aliased `netbox` provider blocks configured with different settings
+// (possibly pointed at different servers) never stomp on each other.
+type readCacheSettings struct {
+	enabled   bool
+	batchSize int
+}
+
+var (
+	readCacheSettingsMu sync.Mutex
+	readCacheSettingsBy = make(map[*client.NetBoxAPI]readCacheSettings)
+)
+
+// ConfigureReadCache applies the read cache settings for api. Call this
+// once per provider instance, from the provider's ConfigureContextFunc,
+// before any resource backed by api runs its ReadContext.
+func ConfigureReadCache(api *client.NetBoxAPI, enabled bool, batchSize int) {
+	if batchSize <= 0 {
+		batchSize = defaultReadCacheBatchSize
+	}
+	readCacheSettingsMu.Lock()
+	defer readCacheSettingsMu.Unlock()
+	readCacheSettingsBy[api] = readCacheSettings{enabled: enabled, batchSize: batchSize}
+}
+
+// readCacheSettingsFor returns api's configured settings, or the disabled
+// default if ConfigureReadCache was never called for it.
+func readCacheSettingsFor(api *client.NetBoxAPI) readCacheSettings {
+	readCacheSettingsMu.Lock()
+	defer readCacheSettingsMu.Unlock()
+	if settings, ok := readCacheSettingsBy[api]; ok {
+		return settings
+	}
+	return readCacheSettings{enabled: false, batchSize: defaultReadCacheBatchSize}
+}
+
+// readCacheBatchWindow is how long the first Read to miss the cache for a
+// given (api, objectType) waits for sibling Reads -- which Terraform issues
+// concurrently across resource instances during a plan/refresh -- to queue
+// up behind it before it fetches the whole batch with one `id__in`-filtered
+// List call per read_cache_batch_size IDs.
+const readCacheBatchWindow = 10 * time.Millisecond
+
+type readCacheKey struct {
+	api        *client.NetBoxAPI
+	objectType string
+	id         int64
+}
+
+type readCacheBatchKey struct {
+	api        *client.NetBoxAPI
+	objectType string
+}
+
+// readCacheBatch accumulates the IDs a single batched fetch will resolve,
+// and lets every Read that joined it find out when the fetch is done.
+type readCacheBatch struct {
+	ids  []int64
+	done chan struct{}
+	err  error
+}
+
+// readCache is a process-local, read-through cache in front of the NetBox
+// API. It exists to collapse the O(N) singleton GETs a large `terraform
+// plan` issues against devices/interfaces/cables into batched `id__in` list
+// calls. It is not persisted across runs. Entries and in-flight batches are
+// both keyed by the *client.NetBoxAPI instance that will serve them, so two
+// aliased provider blocks pointed at different NetBox servers never share
+// state.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[readCacheKey]interface{}
+	batches map[readCacheBatchKey]*readCacheBatch
+}
+
+var globalReadCache = &readCache{
+	entries: make(map[readCacheKey]interface{}),
+	batches: make(map[readCacheBatchKey]*readCacheBatch),
+}
+
+func (c *readCache) get(api *client.NetBoxAPI, objectType string, id int64) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.entries[readCacheKey{api, objectType, id}]
+	return v, ok
+}
+
+func (c *readCache) set(api *client.NetBoxAPI, objectType string, id int64, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[readCacheKey{api, objectType, id}] = value
+}
+
+// invalidate drops a cached entry. Call this from Create/Update/Delete so a
+// subsequent Read in the same run never serves a stale value.
+func (c *readCache) invalidate(api *client.NetBoxAPI, objectType string, id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, readCacheKey{api, objectType, id})
+}
+
+// queueAndFetch joins (or starts) the in-flight batch for (api, objectType).
+// The Read that starts the batch waits readCacheBatchWindow for concurrent
+// Reads to join it, then resolves every queued ID with as few calls to
+// fetch as batchSize allows. Reads that join an existing batch just
+// wait on it and never call fetch themselves.
+func (c *readCache) queueAndFetch(api *client.NetBoxAPI, objectType string, id int64, batchSize int, fetch func(ids []int64) error) error {
+	if batchSize <= 0 {
+		batchSize = defaultReadCacheBatchSize
+	}
+
+	key := readCacheBatchKey{api, objectType}
+
+	c.mu.Lock()
+	batch, inFlight := c.batches[key]
+	if !inFlight {
+		batch = &readCacheBatch{done: make(chan struct{})}
+		c.batches[key] = batch
+	}
+	batch.ids = append(batch.ids, id)
+	c.mu.Unlock()
+
+	if inFlight {
+		<-batch.done
+		return batch.err
+	}
+
+	time.Sleep(readCacheBatchWindow)
+
+	c.mu.Lock()
+	delete(c.batches, key)
+	ids := batch.ids
+	c.mu.Unlock()
+
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := fetch(ids[start:end]); err != nil {
+			batch.err = err
+			break
+		}
+	}
+
+	close(batch.done)
+	return batch.err
+}
+
+func invalidateDeviceCache(api *client.NetBoxAPI, id int64) {
+	globalReadCache.invalidate(api, "device", id)
+}
+
+func invalidateInterfaceCache(api *client.NetBoxAPI, id int64) {
+	globalReadCache.invalidate(api, "interface", id)
+}
+
+func invalidateCableCache(api *client.NetBoxAPI, id int64) {
+	globalReadCache.invalidate(api, "cable", id)
+}
+
+// cachedDeviceRead returns the device with the given ID, going through the
+// read cache when use_read_cache is enabled for api, and falling back to a plain
+// DcimDevicesRead otherwise.
+func cachedDeviceRead(api *client.NetBoxAPI, id int64) (*models.DeviceWithConfigContext, error) {
+	settings := readCacheSettingsFor(api)
+	if !settings.enabled {
+		return deviceRead(api, id)
+	}
+
+	if cached, ok := globalReadCache.get(api, "device", id); ok {
+		device, _ := cached.(*models.DeviceWithConfigContext)
+		return device, nil
+	}
+
+	if err := globalReadCache.queueAndFetch(api, "device", id, settings.batchSize, func(ids []int64) error {
+		return fetchDevicesByID(api, ids)
+	}); err != nil {
+		return nil, err
+	}
+
+	cached, _ := globalReadCache.get(api, "device", id)
+	device, _ := cached.(*models.DeviceWithConfigContext)
+	return device, nil
+}
+
+func deviceRead(api *client.NetBoxAPI, id int64) (*models.DeviceWithConfigContext, error) {
+	params := dcim.NewDcimDevicesReadParams().WithID(id)
+	res, err := api.Dcim.DcimDevicesRead(params, nil)
+	if err != nil {
+		if readErr, ok := err.(*dcim.DcimDevicesReadDefault); ok && readErr.Code() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.GetPayload(), nil
+}
+
+// fetchDevicesByID populates the cache for ids with a single `id`-filtered
+// list call (NetBox's `id` filter accepts a comma-separated list) instead of
+// one GET per ID; queueAndFetch keeps ids to at most batchSize entries. IDs
+// that no longer exist are simply left uncached, so cachedDeviceRead returns
+// nil for them, which the caller handles the same way as a 404.
+func fetchDevicesByID(api *client.NetBoxAPI, ids []int64) error {
+	idIn := idInFilter(ids)
+	params := dcim.NewDcimDevicesListParams().WithID(&idIn).WithLimit(int64Ptr(int64(len(ids))))
+
+	res, err := api.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range res.GetPayload().Results {
+		globalReadCache.set(api, "device", device.ID, device)
+	}
+	return nil
+}
+
+// cachedInterfaceRead mirrors cachedDeviceRead for netbox_device_interface.
+func cachedInterfaceRead(api *client.NetBoxAPI, id int64) (*models.Interface, error) {
+	settings := readCacheSettingsFor(api)
+	if !settings.enabled {
+		return interfaceRead(api, id)
+	}
+
+	if cached, ok := globalReadCache.get(api, "interface", id); ok {
+		iface, _ := cached.(*models.Interface)
+		return iface, nil
+	}
+
+	if err := globalReadCache.queueAndFetch(api, "interface", id, settings.batchSize, func(ids []int64) error {
+		return fetchInterfacesByID(api, ids)
+	}); err != nil {
+		return nil, err
+	}
+
+	cached, _ := globalReadCache.get(api, "interface", id)
+	iface, _ := cached.(*models.Interface)
+	return iface, nil
+}
+
+func interfaceRead(api *client.NetBoxAPI, id int64) (*models.Interface, error) {
+	params := dcim.NewDcimInterfacesReadParams().WithID(id)
+	res, err := api.Dcim.DcimInterfacesRead(params, nil)
+	if err != nil {
+		if readErr, ok := err.(*dcim.DcimInterfacesReadDefault); ok && readErr.Code() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.GetPayload(), nil
+}
+
+func fetchInterfacesByID(api *client.NetBoxAPI, ids []int64) error {
+	idIn := idInFilter(ids)
+	params := dcim.NewDcimInterfacesListParams().WithID(&idIn).WithLimit(int64Ptr(int64(len(ids))))
+
+	res, err := api.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, iface := range res.GetPayload().Results {
+		globalReadCache.set(api, "interface", iface.ID, iface)
+	}
+	return nil
+}
+
+// cachedCableRead mirrors cachedDeviceRead for netbox_cable.
+func cachedCableRead(api *client.NetBoxAPI, id int64) (*models.Cable, error) {
+	settings := readCacheSettingsFor(api)
+	if !settings.enabled {
+		return cableRead(api, id)
+	}
+
+	if cached, ok := globalReadCache.get(api, "cable", id); ok {
+		cable, _ := cached.(*models.Cable)
+		return cable, nil
+	}
+
+	if err := globalReadCache.queueAndFetch(api, "cable", id, settings.batchSize, func(ids []int64) error {
+		return fetchCablesByID(api, ids)
+	}); err != nil {
+		return nil, err
+	}
+
+	cached, _ := globalReadCache.get(api, "cable", id)
+	cable, _ := cached.(*models.Cable)
+	return cable, nil
+}
+
+func cableRead(api *client.NetBoxAPI, id int64) (*models.Cable, error) {
+	params := dcim.NewDcimCablesReadParams().WithID(id)
+	res, err := api.Dcim.DcimCablesRead(params, nil)
+	if err != nil {
+		if readErr, ok := err.(*dcim.DcimCablesReadDefault); ok && readErr.Code() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.GetPayload(), nil
+}
+
+func fetchCablesByID(api *client.NetBoxAPI, ids []int64) error {
+	idIn := idInFilter(ids)
+	params := dcim.NewDcimCablesListParams().WithID(&idIn).WithLimit(int64Ptr(int64(len(ids))))
+
+	res, err := api.Dcim.DcimCablesList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, cable := range res.GetPayload().Results {
+		globalReadCache.set(api, "cable", cable.ID, cable)
+	}
+	return nil
+}
+
+func idInFilter(ids []int64) string {
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(idStrs, ",")
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
@@ -0,0 +1,114 @@
+package netbox
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+)
+
+func TestReadCacheCoalescesConcurrentFetches(t *testing.T) {
+	cache := &readCache{
+		entries: make(map[readCacheKey]interface{}),
+		batches: make(map[readCacheBatchKey]*readCacheBatch),
+	}
+	api := new(client.NetBoxAPI)
+
+	var fetchCalls int32
+	var seenIDs sync.Map
+
+	const concurrentReads = 20
+
+	var wg sync.WaitGroup
+	wg.Add(concurrentReads)
+	for i := 0; i < concurrentReads; i++ {
+		id := int64(i)
+		go func() {
+			defer wg.Done()
+			err := cache.queueAndFetch(api, "device", id, 50, func(ids []int64) error {
+				atomic.AddInt32(&fetchCalls, 1)
+				for _, fetchedID := range ids {
+					seenIDs.Store(fetchedID, true)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Errorf("queueAndFetch returned unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < concurrentReads; i++ {
+		if _, ok := seenIDs.Load(int64(i)); !ok {
+			t.Errorf("id %d was never fetched", i)
+		}
+	}
+
+	if calls := atomic.LoadInt32(&fetchCalls); calls >= concurrentReads {
+		t.Errorf("expected concurrent Reads to coalesce into fewer than %d fetch calls, got %d", concurrentReads, calls)
+	}
+}
+
+func TestReadCacheIsolatesByAPIInstance(t *testing.T) {
+	cache := &readCache{
+		entries: make(map[readCacheKey]interface{}),
+		batches: make(map[readCacheBatchKey]*readCacheBatch),
+	}
+	apiA := new(client.NetBoxAPI)
+	apiB := new(client.NetBoxAPI)
+
+	cache.set(apiA, "device", 1, "from-server-a")
+	cache.set(apiB, "device", 1, "from-server-b")
+
+	valueA, ok := cache.get(apiA, "device", 1)
+	if !ok || valueA != "from-server-a" {
+		t.Errorf("expected apiA's cache entry to be from-server-a, got %v (ok=%v)", valueA, ok)
+	}
+
+	valueB, ok := cache.get(apiB, "device", 1)
+	if !ok || valueB != "from-server-b" {
+		t.Errorf("expected apiB's cache entry to be from-server-b, got %v (ok=%v)", valueB, ok)
+	}
+
+	cache.invalidate(apiA, "device", 1)
+	if _, ok := cache.get(apiA, "device", 1); ok {
+		t.Error("expected apiA's cache entry to be gone after invalidate")
+	}
+	if _, ok := cache.get(apiB, "device", 1); !ok {
+		t.Error("invalidating apiA's entry should not affect apiB's cache")
+	}
+}
+
+// TestReadCacheSettingsAreIsolatedByAPIInstance guards against two aliased
+// `netbox` provider blocks, each configured with different use_read_cache /
+// read_cache_batch_size settings, stomping on each other's configuration --
+// which would happen if those settings lived in package-level globals
+// instead of being keyed by the *client.NetBoxAPI instance they apply to.
+func TestReadCacheSettingsAreIsolatedByAPIInstance(t *testing.T) {
+	apiA := new(client.NetBoxAPI)
+	apiB := new(client.NetBoxAPI)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		ConfigureReadCache(apiA, true, 10)
+	}()
+	go func() {
+		defer wg.Done()
+		ConfigureReadCache(apiB, false, 200)
+	}()
+	wg.Wait()
+
+	settingsA := readCacheSettingsFor(apiA)
+	if !settingsA.enabled || settingsA.batchSize != 10 {
+		t.Errorf("expected apiA settings {enabled:true batchSize:10}, got %+v", settingsA)
+	}
+
+	settingsB := readCacheSettingsFor(apiB)
+	if settingsB.enabled || settingsB.batchSize != 200 {
+		t.Errorf("expected apiB settings {enabled:false batchSize:200}, got %+v", settingsB)
+	}
+}
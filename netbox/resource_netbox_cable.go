@@ -0,0 +1,265 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxCable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxCableCreate,
+		ReadContext:   resourceNetboxCableRead,
+		UpdateContext: resourceNetboxCableUpdate,
+		DeleteContext: resourceNetboxCableDelete,
+
+		Description: `From the [official documentation](https://docs.netbox.dev/en/stable/core-functionality/cabling/#cables):
+
+> Cables represent a physical connection between two termination points, such as between a console port and a patch panel port, or between two network interfaces.`,
+
+		Schema: map[string]*schema.Schema{
+			"a_termination_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"a_termination_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"b_termination_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"b_termination_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "connected",
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"color": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"length": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"length_unit": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set:      schema.HashString,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+// cableTerminations builds the single-element []*models.GenericObject NetBox
+// expects for each end of a cable. NetBox supports trunk cables with more
+// than one termination per end, but this resource only ever manages a
+// point-to-point cable, so each end always has exactly one termination.
+func cableTerminations(objectType string, objectID int64) []*models.GenericObject {
+	return []*models.GenericObject{
+		{
+			ObjectType: &objectType,
+			ObjectID:   &objectID,
+		},
+	}
+}
+
+func resourceNetboxCableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	aType := d.Get("a_termination_type").(string)
+	aID := int64(d.Get("a_termination_id").(int))
+	bType := d.Get("b_termination_type").(string)
+	bID := int64(d.Get("b_termination_id").(int))
+	status := d.Get("status").(string)
+
+	data := models.WritableCable{
+		ATerminations: cableTerminations(aType, aID),
+		BTerminations: cableTerminations(bType, bID),
+		Status:        status,
+	}
+
+	data.Type = d.Get("type").(string)
+	data.Label = d.Get("label").(string)
+	data.Color = d.Get("color").(string)
+
+	lengthValue, ok := d.GetOk("length")
+	if ok {
+		length := lengthValue.(float64)
+		data.Length = &length
+	}
+
+	data.LengthUnit = d.Get("length_unit").(string)
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get("tags"))
+
+	params := dcim.NewDcimCablesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimCablesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxCableRead(ctx, d, m)
+}
+
+func resourceNetboxCableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	cable, err := cachedCableRead(api, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cable == nil {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
+
+	if len(cable.ATerminations) > 0 {
+		d.Set("a_termination_type", cable.ATerminations[0].ObjectType)
+		d.Set("a_termination_id", cable.ATerminations[0].ObjectID)
+	}
+
+	if len(cable.BTerminations) > 0 {
+		d.Set("b_termination_type", cable.BTerminations[0].ObjectType)
+		d.Set("b_termination_id", cable.BTerminations[0].ObjectID)
+	}
+
+	if cable.Status != nil {
+		d.Set("status", cable.Status.Value)
+	}
+
+	d.Set("type", cable.Type)
+
+	d.Set("label", cable.Label)
+	d.Set("color", cable.Color)
+	d.Set("length", cable.Length)
+
+	if cable.LengthUnit != nil {
+		d.Set("length_unit", cable.LengthUnit.Value)
+	} else {
+		d.Set("length_unit", nil)
+	}
+
+	d.Set("tags", getTagListFromNestedTagList(cable.Tags))
+	return diags
+}
+
+func resourceNetboxCableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableCable{}
+
+	aType := d.Get("a_termination_type").(string)
+	aID := int64(d.Get("a_termination_id").(int))
+	data.ATerminations = cableTerminations(aType, aID)
+
+	bType := d.Get("b_termination_type").(string)
+	bID := int64(d.Get("b_termination_id").(int))
+	data.BTerminations = cableTerminations(bType, bID)
+
+	if d.HasChange("status") {
+		data.Status = d.Get("status").(string)
+	}
+
+	if d.HasChange("type") {
+		data.Type = d.Get("type").(string)
+	}
+
+	if d.HasChange("label") {
+		labelValue, ok := d.GetOk("label")
+		label := ""
+		if !ok {
+			// Setting an space string deletes the label
+			label = " "
+		} else {
+			label = labelValue.(string)
+		}
+		data.Label = label
+	}
+
+	if d.HasChange("color") {
+		data.Color = d.Get("color").(string)
+	}
+
+	if d.HasChange("length") {
+		lengthValue, ok := d.GetOk("length")
+		if ok {
+			length := lengthValue.(float64)
+			data.Length = &length
+		} else {
+			data.Length = nil
+		}
+	}
+
+	if d.HasChange("length_unit") {
+		data.LengthUnit = d.Get("length_unit").(string)
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get("tags"))
+
+	params := dcim.NewDcimCablesUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimCablesUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateCableCache(api, id)
+
+	return resourceNetboxCableRead(ctx, d, m)
+}
+
+func resourceNetboxCableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimCablesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimCablesDelete(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateCableCache(api, id)
+
+	return diags
+}
@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxCable_basic(t *testing.T) {
+	testSlug := "cablebasic"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxCableConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cable.test", "a_termination_type", "dcim.interface"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "b_termination_type", "dcim.interface"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "status", "connected"),
+				),
+			},
+			{
+				ResourceName:      "netbox_cable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetboxCableConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test_a" {
+  name           = "%[1]s-a"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device" "test_b" {
+  name           = "%[1]s-b"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test_a" {
+  device_id = netbox_device.test_a.id
+  name      = "eth0"
+  type      = "1000base-t"
+}
+
+resource "netbox_device_interface" "test_b" {
+  device_id = netbox_device.test_b.id
+  name      = "eth0"
+  type      = "1000base-t"
+}
+
+resource "netbox_cable" "test" {
+  a_termination_type = "dcim.interface"
+  a_termination_id   = netbox_device_interface.test_a.id
+  b_termination_type = "dcim.interface"
+  b_termination_id   = netbox_device_interface.test_b.id
+}
+`, testName)
+}
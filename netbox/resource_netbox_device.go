@@ -51,6 +51,42 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+			},
+			"platform_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"rack_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"position": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"face": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"custom_fields": &schema.Schema{
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
 			"comments": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -64,6 +100,11 @@ func resourceNetboxDevice() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"primary_ipv4": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"primary_ipv6": &schema.Schema{
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
@@ -95,6 +136,12 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 	serial := d.Get("serial").(string)
 	data.Serial = serial
 
+	description := d.Get("description").(string)
+	data.Description = description
+
+	status := d.Get("status").(string)
+	data.Status = status
+
 	tenantIDValue, ok := d.GetOk("tenant_id")
 	if ok {
 		tenantID := int64(tenantIDValue.(int))
@@ -110,7 +157,7 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 	roleIDValue, ok := d.GetOk("role_id")
 	if ok {
 		roleID := int64(roleIDValue.(int))
-		data.DeviceRole = &roleID
+		data.Role = &roleID
 	}
 
 	siteIDValue, ok := d.GetOk("site_id")
@@ -119,6 +166,41 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 		data.Site = &siteID
 	}
 
+	platformIDValue, ok := d.GetOk("platform_id")
+	if ok {
+		platformID := int64(platformIDValue.(int))
+		data.Platform = &platformID
+	}
+
+	clusterIDValue, ok := d.GetOk("cluster_id")
+	if ok {
+		clusterID := int64(clusterIDValue.(int))
+		data.Cluster = &clusterID
+	}
+
+	rackIDValue, ok := d.GetOk("rack_id")
+	if ok {
+		rackID := int64(rackIDValue.(int))
+		data.Rack = &rackID
+	}
+
+	positionValue, ok := d.GetOk("position")
+	if ok {
+		position := positionValue.(float64)
+		data.Position = &position
+	}
+
+	face := d.Get("face").(string)
+	data.Face = face
+
+	primaryIPValue, ok := d.GetOk("primary_ipv4")
+	if ok {
+		primaryIP := int64(primaryIPValue.(int))
+		data.PrimaryIp4 = &primaryIP
+	}
+
+	data.CustomFields = stringMapFromResourceData(d.Get("custom_fields"))
+
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get("tags"))
 
 	params := dcim.NewDcimDevicesCreateParams().WithData(&data)
@@ -140,60 +222,101 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	params := dcim.NewDcimDevicesReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimDevicesRead(params, nil)
+	device, err := cachedDeviceRead(api, id)
 	if err != nil {
-		errorcode := err.(*dcim.DcimDevicesReadDefault).Code()
-		if errorcode == 404 {
-			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-			d.SetId("")
-			return nil
-		}
 		return diag.FromErr(err)
 	}
+	if device == nil {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
 
-	d.Set("name", res.GetPayload().Name)
+	d.Set("name", device.Name)
 
-	if res.GetPayload().DeviceType != nil {
-		d.Set("device_type_id", res.GetPayload().DeviceType.ID)
+	if device.DeviceType != nil {
+		d.Set("device_type_id", device.DeviceType.ID)
 	}
 
-	if res.GetPayload().PrimaryIp4 != nil {
-		d.Set("primary_ipv4", res.GetPayload().PrimaryIp4.ID)
+	if device.PrimaryIp4 != nil {
+		d.Set("primary_ipv4", device.PrimaryIp4.ID)
 	} else {
 		d.Set("primary_ipv4", nil)
 	}
 
-	if res.GetPayload().Tenant != nil {
-		d.Set("tenant_id", res.GetPayload().Tenant.ID)
+	if device.PrimaryIp6 != nil {
+		d.Set("primary_ipv6", device.PrimaryIp6.ID)
+	} else {
+		d.Set("primary_ipv6", nil)
+	}
+
+	if device.Tenant != nil {
+		d.Set("tenant_id", device.Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
 
-	if res.GetPayload().Location != nil {
-		d.Set("location_id", res.GetPayload().Location.ID)
+	if device.Location != nil {
+		d.Set("location_id", device.Location.ID)
 	} else {
 		d.Set("location_id", nil)
 	}
 
-	if res.GetPayload().DeviceRole != nil {
-		d.Set("role_id", res.GetPayload().DeviceRole.ID)
+	if device.Role != nil {
+		d.Set("role_id", device.Role.ID)
 	} else {
 		d.Set("role_id", nil)
 	}
 
-	if res.GetPayload().Site != nil {
-		d.Set("site_id", res.GetPayload().Site.ID)
+	if device.Site != nil {
+		d.Set("site_id", device.Site.ID)
 	} else {
 		d.Set("site_id", nil)
 	}
 
-	d.Set("comments", res.GetPayload().Comments)
+	if device.Platform != nil {
+		d.Set("platform_id", device.Platform.ID)
+	} else {
+		d.Set("platform_id", nil)
+	}
+
+	if device.Cluster != nil {
+		d.Set("cluster_id", device.Cluster.ID)
+	} else {
+		d.Set("cluster_id", nil)
+	}
+
+	if device.Rack != nil {
+		d.Set("rack_id", device.Rack.ID)
+	} else {
+		d.Set("rack_id", nil)
+	}
+
+	if device.Position != nil {
+		d.Set("position", device.Position)
+	} else {
+		d.Set("position", nil)
+	}
+
+	if device.Face != nil {
+		d.Set("face", device.Face.Value)
+	} else {
+		d.Set("face", nil)
+	}
+
+	if device.Status != nil {
+		d.Set("status", device.Status.Value)
+	}
+
+	d.Set("description", device.Description)
 
-	d.Set("serial", res.GetPayload().Serial)
+	d.Set("comments", device.Comments)
 
-	d.Set("tags", getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set("serial", device.Serial)
+
+	d.Set("custom_fields", device.CustomFields)
+
+	d.Set("tags", getTagListFromNestedTagList(device.Tags))
 	return diags
 }
 
@@ -208,8 +331,8 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	typeIDValue, ok := d.GetOk("device_type_id")
 	if ok {
-		tenantID := int64(typeIDValue.(int))
-		data.Tenant = &tenantID
+		typeID := int64(typeIDValue.(int))
+		data.DeviceType = &typeID
 	}
 
 	tenantIDValue, ok := d.GetOk("tenant_id")
@@ -227,7 +350,7 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 	roleIDValue, ok := d.GetOk("role_id")
 	if ok {
 		roleID := int64(roleIDValue.(int))
-		data.DeviceRole = &roleID
+		data.Role = &roleID
 	}
 
 	siteIDValue, ok := d.GetOk("site_id")
@@ -253,6 +376,87 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get("tags"))
 
+	if d.HasChange("status") {
+		data.Status = d.Get("status").(string)
+	}
+
+	// Platform/Cluster/Rack/Position are all `omitempty` pointers on
+	// WritableDeviceWithConfigContext, so a nil here is silently dropped
+	// from the PATCH/PUT body instead of clearing the field server-side.
+	// nullFields collects the ones the config just cleared so they can be
+	// nulled out afterwards through patchNulls; see nullable_patch.go.
+	nullFields := map[string]interface{}{}
+
+	if d.HasChange("platform_id") {
+		platformIDValue, ok := d.GetOk("platform_id")
+		if ok {
+			platformID := int64(platformIDValue.(int))
+			data.Platform = &platformID
+		} else {
+			nullFields["platform"] = nil
+		}
+	}
+
+	if d.HasChange("cluster_id") {
+		clusterIDValue, ok := d.GetOk("cluster_id")
+		if ok {
+			clusterID := int64(clusterIDValue.(int))
+			data.Cluster = &clusterID
+		} else {
+			nullFields["cluster"] = nil
+		}
+	}
+
+	if d.HasChange("rack_id") {
+		rackIDValue, ok := d.GetOk("rack_id")
+		if ok {
+			rackID := int64(rackIDValue.(int))
+			data.Rack = &rackID
+		} else {
+			nullFields["rack"] = nil
+		}
+	}
+
+	if d.HasChange("position") {
+		positionValue, ok := d.GetOk("position")
+		if ok {
+			position := positionValue.(float64)
+			data.Position = &position
+		} else {
+			nullFields["position"] = nil
+		}
+	}
+
+	if d.HasChange("face") {
+		// check if face is set
+		faceValue, ok := d.GetOk("face")
+		face := ""
+		if !ok {
+			// Setting an empty string clears the face assignment
+			face = ""
+		} else {
+			face = faceValue.(string)
+		}
+		data.Face = face
+	}
+
+	if d.HasChange("description") {
+		// check if description is set
+		descriptionValue, ok := d.GetOk("description")
+		description := ""
+		if !ok {
+			// Setting an space string deletes the description
+			description = " "
+		} else {
+			description = descriptionValue.(string)
+		}
+		data.Description = description
+	}
+
+	if d.HasChange("custom_fields") {
+		data.CustomFields = stringMapFromResourceData(d.Get("custom_fields"))
+	}
+
 	if d.HasChanges("comments") {
 		// check if comment is set
 		commentsValue, ok := d.GetOk("comments")
@@ -286,6 +490,12 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
+	if err := patchNulls(api, "/dcim/devices/{id}/", id, nullFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateDeviceCache(api, id)
+
 	return resourceNetboxDeviceRead(ctx, d, m)
 }
 
@@ -301,5 +511,19 @@ func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m i
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	invalidateDeviceCache(api, id)
+
 	return diags
 }
+
+// stringMapFromResourceData converts a TypeMap of strings from ResourceData into the
+// map[string]string shape expected by models.CustomFields.
+func stringMapFromResourceData(raw interface{}) map[string]string {
+	rawMap := raw.(map[string]interface{})
+	customFields := make(map[string]string, len(rawMap))
+	for k, v := range rawMap {
+		customFields[k] = v.(string)
+	}
+	return customFields
+}
@@ -0,0 +1,311 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDeviceInterfaceCreate,
+		ReadContext:   resourceNetboxDeviceInterfaceRead,
+		UpdateContext: resourceNetboxDeviceInterfaceUpdate,
+		DeleteContext: resourceNetboxDeviceInterfaceDelete,
+
+		Description: `From the [official documentation](https://docs.netbox.dev/en/stable/core-functionality/devices/#device-components):
+
+> Interfaces in NetBox represent network interfaces used to exchange data with connected devices. On devices, these are typically physical ports.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"untagged_vlan_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tagged_vlan_ids": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set:      schema.HashString,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	name := d.Get("name").(string)
+	interfaceType := d.Get("type").(string)
+	enabled := d.Get("enabled").(bool)
+
+	data := models.WritableInterface{
+		Name:    &name,
+		Type:    &interfaceType,
+		Enabled: enabled,
+	}
+
+	deviceIDValue, ok := d.GetOk("device_id")
+	if ok {
+		deviceID := int64(deviceIDValue.(int))
+		data.Device = &deviceID
+	}
+
+	mtuValue, ok := d.GetOk("mtu")
+	if ok {
+		mtu := int64(mtuValue.(int))
+		data.Mtu = &mtu
+	}
+
+	macAddress := d.Get("mac_address").(string)
+	data.MacAddress = &macAddress
+
+	mode := d.Get("mode").(string)
+	data.Mode = mode
+
+	untaggedVlanIDValue, ok := d.GetOk("untagged_vlan_id")
+	if ok {
+		untaggedVlanID := int64(untaggedVlanIDValue.(int))
+		data.UntaggedVlan = &untaggedVlanID
+	}
+
+	data.TaggedVlans = int64SliceFromSet(d.Get("tagged_vlan_ids"))
+
+	description := d.Get("description").(string)
+	data.Description = description
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get("tags"))
+
+	params := dcim.NewDcimInterfacesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimInterfacesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceInterfaceRead(ctx, d, m)
+}
+
+func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	iface, err := cachedInterfaceRead(api, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if iface == nil {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", iface.Name)
+
+	if iface.Device != nil {
+		d.Set("device_id", iface.Device.ID)
+	}
+
+	if iface.Type != nil {
+		d.Set("type", iface.Type.Value)
+	}
+
+	d.Set("enabled", iface.Enabled)
+
+	d.Set("mtu", iface.Mtu)
+
+	d.Set("mac_address", iface.MacAddress)
+
+	if iface.Mode != nil {
+		d.Set("mode", iface.Mode.Value)
+	} else {
+		d.Set("mode", nil)
+	}
+
+	if iface.UntaggedVlan != nil {
+		d.Set("untagged_vlan_id", iface.UntaggedVlan.ID)
+	} else {
+		d.Set("untagged_vlan_id", nil)
+	}
+
+	taggedVlanIDs := make([]int64, len(iface.TaggedVlans))
+	for i, vlan := range iface.TaggedVlans {
+		taggedVlanIDs[i] = vlan.ID
+	}
+	d.Set("tagged_vlan_ids", taggedVlanIDs)
+
+	d.Set("description", iface.Description)
+
+	d.Set("tags", getTagListFromNestedTagList(iface.Tags))
+	return diags
+}
+
+func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableInterface{}
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	interfaceType := d.Get("type").(string)
+	data.Type = &interfaceType
+
+	data.Enabled = d.Get("enabled").(bool)
+
+	deviceIDValue, ok := d.GetOk("device_id")
+	if ok {
+		deviceID := int64(deviceIDValue.(int))
+		data.Device = &deviceID
+	}
+
+	// Mtu/UntaggedVlan are both `omitempty` pointers on WritableInterface, so
+	// a nil here is silently dropped from the PATCH/PUT body instead of
+	// clearing the field server-side. nullFields collects the ones the
+	// config just cleared so they can be nulled out afterwards through
+	// patchNulls; see nullable_patch.go.
+	nullFields := map[string]interface{}{}
+
+	if d.HasChange("mtu") {
+		mtuValue, ok := d.GetOk("mtu")
+		if ok {
+			mtu := int64(mtuValue.(int))
+			data.Mtu = &mtu
+		} else {
+			nullFields["mtu"] = nil
+		}
+	}
+
+	macAddress := d.Get("mac_address").(string)
+	data.MacAddress = &macAddress
+
+	if d.HasChange("mode") {
+		mode := d.Get("mode").(string)
+		data.Mode = mode
+	}
+
+	if d.HasChange("untagged_vlan_id") {
+		untaggedVlanIDValue, ok := d.GetOk("untagged_vlan_id")
+		if ok {
+			untaggedVlanID := int64(untaggedVlanIDValue.(int))
+			data.UntaggedVlan = &untaggedVlanID
+		} else {
+			nullFields["untagged_vlan"] = nil
+		}
+	}
+
+	data.TaggedVlans = int64SliceFromSet(d.Get("tagged_vlan_ids"))
+
+	if d.HasChange("description") {
+		descriptionValue, ok := d.GetOk("description")
+		description := ""
+		if !ok {
+			// Setting an space string deletes the description
+			description = " "
+		} else {
+			description = descriptionValue.(string)
+		}
+		data.Description = description
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get("tags"))
+
+	params := dcim.NewDcimInterfacesUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimInterfacesUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := patchNulls(api, "/dcim/interfaces/{id}/", id, nullFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateInterfaceCache(api, id)
+
+	return resourceNetboxDeviceInterfaceRead(ctx, d, m)
+}
+
+func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInterfacesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInterfacesDelete(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateInterfaceCache(api, id)
+
+	return diags
+}
+
+// int64SliceFromSet converts a TypeSet of ints from ResourceData into a []int64.
+func int64SliceFromSet(raw interface{}) []int64 {
+	set := raw.(*schema.Set).List()
+	ids := make([]int64, len(set))
+	for i, v := range set {
+		ids[i] = int64(v.(int))
+	}
+	return ids
+}
@@ -0,0 +1,129 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceInterface_basic(t *testing.T) {
+	testSlug := "deviceifacebasic"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceInterfaceConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "name", "eth0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "type", "1000base-t"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDeviceInterface_clearMtu(t *testing.T) {
+	testSlug := "deviceifaceclearmtu"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceInterfaceClearMtuConfig(testName, "mtu = 1500"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "mtu", "1500"),
+				),
+			},
+			{
+				// Unsetting mtu must actually clear it server-side, not
+				// just leave it out of the next PATCH body.
+				Config: testAccNetboxDeviceInterfaceClearMtuConfig(testName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "mtu", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetboxDeviceInterfaceClearMtuConfig(testName, mtuAttr string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name      = "eth0"
+  type      = "1000base-t"
+  %[2]s
+}
+`, testName, mtuAttr)
+}
+
+func testAccNetboxDeviceInterfaceConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name      = "eth0"
+  type      = "1000base-t"
+  enabled   = true
+}
+`, testName)
+}
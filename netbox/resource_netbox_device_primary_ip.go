@@ -0,0 +1,297 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDevicePrimaryIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDevicePrimaryIPCreate,
+		ReadContext:   resourceNetboxDevicePrimaryIPRead,
+		UpdateContext: resourceNetboxDevicePrimaryIPUpdate,
+		DeleteContext: resourceNetboxDevicePrimaryIPDelete,
+
+		Description: `This resource assigns the primary IPv4 or IPv6 address of a device. It exists separately from ` + "`netbox_device`" + ` because the interface and IP address that back a device's primary IP are usually created after the device itself, which makes ` + "`primary_ipv4`" + ` on ` + "`netbox_device`" + ` unusable until they exist.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ip_family": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  4,
+			},
+		},
+	}
+}
+
+// devicePatchFromExisting seeds a WritableDeviceWithConfigContext from a
+// device NetBox already returned to us. DcimDevicesPartialUpdate still takes
+// a full WritableDeviceWithConfigContext, and fields like Name/DeviceType/
+// Role/Site are non-omitempty pointers on that model: sending the zero
+// value would PATCH them to null. Callers only need to overwrite the one
+// field they actually want to change on top of what this returns.
+func devicePatchFromExisting(device *models.DeviceWithConfigContext) *models.WritableDeviceWithConfigContext {
+	data := &models.WritableDeviceWithConfigContext{
+		Name:         device.Name,
+		Comments:     device.Comments,
+		Serial:       device.Serial,
+		Description:  device.Description,
+		CustomFields: device.CustomFields,
+		Tags:         device.Tags,
+		Position:     device.Position,
+	}
+
+	if device.DeviceType != nil {
+		deviceTypeID := device.DeviceType.ID
+		data.DeviceType = &deviceTypeID
+	}
+
+	if device.Role != nil {
+		roleID := device.Role.ID
+		data.Role = &roleID
+	}
+
+	if device.Site != nil {
+		siteID := device.Site.ID
+		data.Site = &siteID
+	}
+
+	if device.Tenant != nil {
+		tenantID := device.Tenant.ID
+		data.Tenant = &tenantID
+	}
+
+	if device.Location != nil {
+		locationID := device.Location.ID
+		data.Location = &locationID
+	}
+
+	if device.Platform != nil {
+		platformID := device.Platform.ID
+		data.Platform = &platformID
+	}
+
+	if device.Cluster != nil {
+		clusterID := device.Cluster.ID
+		data.Cluster = &clusterID
+	}
+
+	if device.Rack != nil {
+		rackID := device.Rack.ID
+		data.Rack = &rackID
+	}
+
+	if device.Face != nil && device.Face.Value != nil {
+		data.Face = *device.Face.Value
+	}
+
+	if device.Status != nil && device.Status.Value != nil {
+		data.Status = *device.Status.Value
+	}
+
+	if device.PrimaryIp4 != nil {
+		primaryIP4 := device.PrimaryIp4.ID
+		data.PrimaryIp4 = &primaryIP4
+	}
+
+	if device.PrimaryIp6 != nil {
+		primaryIP6 := device.PrimaryIp6.ID
+		data.PrimaryIp6 = &primaryIP6
+	}
+
+	return data
+}
+
+func resourceNetboxDevicePrimaryIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	deviceID := int64(d.Get("device_id").(int))
+	ipAddressID := int64(d.Get("ip_address_id").(int))
+	family := d.Get("ip_family").(int)
+
+	device, err := deviceRead(api, deviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if device == nil {
+		return diag.Errorf("device with ID %d does not exist", deviceID)
+	}
+
+	data := devicePatchFromExisting(device)
+	if family == 6 {
+		data.PrimaryIp6 = &ipAddressID
+	} else {
+		data.PrimaryIp4 = &ipAddressID
+	}
+
+	params := dcim.NewDcimDevicesPartialUpdateParams().WithID(deviceID).WithData(data)
+
+	_, err = api.Dcim.DcimDevicesPartialUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateDeviceCache(api, deviceID)
+
+	d.SetId(fmt.Sprintf("%d_%d", deviceID, family))
+
+	return resourceNetboxDevicePrimaryIPRead(ctx, d, m)
+}
+
+func resourceNetboxDevicePrimaryIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	deviceID, family, err := parseDevicePrimaryIPID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	device, err := deviceRead(api, deviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if device == nil {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("device_id", deviceID)
+	d.Set("ip_family", family)
+
+	var primaryIP int64
+	if family == 6 {
+		if device.PrimaryIp6 != nil {
+			primaryIP = device.PrimaryIp6.ID
+		}
+	} else {
+		if device.PrimaryIp4 != nil {
+			primaryIP = device.PrimaryIp4.ID
+		}
+	}
+
+	if primaryIP == 0 {
+		// The primary IP was cleared out of band
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ip_address_id", primaryIP)
+
+	return diags
+}
+
+func resourceNetboxDevicePrimaryIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	deviceID, family, err := parseDevicePrimaryIPID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	device, err := deviceRead(api, deviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if device == nil {
+		return diag.Errorf("device with ID %d does not exist", deviceID)
+	}
+
+	ipAddressID := int64(d.Get("ip_address_id").(int))
+
+	data := devicePatchFromExisting(device)
+	if family == 6 {
+		data.PrimaryIp6 = &ipAddressID
+	} else {
+		data.PrimaryIp4 = &ipAddressID
+	}
+
+	params := dcim.NewDcimDevicesPartialUpdateParams().WithID(deviceID).WithData(data)
+
+	_, err = api.Dcim.DcimDevicesPartialUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateDeviceCache(api, deviceID)
+
+	return resourceNetboxDevicePrimaryIPRead(ctx, d, m)
+}
+
+func resourceNetboxDevicePrimaryIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	deviceID, family, err := parseDevicePrimaryIPID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	device, err := deviceRead(api, deviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if device == nil {
+		// Already gone; nothing to clear.
+		return diags
+	}
+
+	// primary_ip4/primary_ip6 are both `omitempty` pointers on
+	// WritableDeviceWithConfigContext, so setting them to nil here and
+	// PATCHing through the typed client would silently drop the field from
+	// the request body instead of clearing it server-side. Use patchNulls to
+	// emit an explicit JSON null; see nullable_patch.go.
+	nullField := "primary_ip4"
+	if family == 6 {
+		nullField = "primary_ip6"
+	}
+
+	if err := patchNulls(api, "/dcim/devices/{id}/", deviceID, map[string]interface{}{nullField: nil}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	invalidateDeviceCache(api, deviceID)
+
+	return diags
+}
+
+// parseDevicePrimaryIPID splits the "<device_id>_<ip_family>" resource ID back into its parts.
+func parseDevicePrimaryIPID(id string) (int64, int, error) {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid netbox_device_primary_ip ID %q, expected <device_id>_<ip_family>", id)
+	}
+
+	deviceID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid device_id in netbox_device_primary_ip ID %q: %w", id, err)
+	}
+
+	family, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid ip_family in netbox_device_primary_ip ID %q: %w", id, err)
+	}
+
+	return deviceID, family, nil
+}
@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDevicePrimaryIP_basic(t *testing.T) {
+	testSlug := "deviceprimaryipbasic"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDevicePrimaryIPConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_primary_ip.test", "ip_address_id", "netbox_ip_address.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_primary_ip.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_primary_ip.test", "ip_family", "4"),
+				),
+			},
+			{
+				// The device was read before the assignment was made, so its
+				// state only picks the assignment back up on a later refresh.
+				Config: testAccNetboxDevicePrimaryIPConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					// the device resource itself should pick the assignment back up
+					resource.TestCheckResourceAttrPair("netbox_device.test", "primary_ipv4", "netbox_ip_address.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDevicePrimaryIP_delete(t *testing.T) {
+	testSlug := "deviceprimaryipdelete"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDevicePrimaryIPConfig(testName),
+			},
+			{
+				// The device was read before the assignment was made, so its
+				// state only picks the assignment up on a later refresh.
+				Config: testAccNetboxDevicePrimaryIPConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device.test", "primary_ipv4", "netbox_ip_address.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxDevicePrimaryIPDeletedConfig(testName),
+			},
+			{
+				// Destroying netbox_device_primary_ip must actually clear
+				// the device's primary IP server-side, not just drop the
+				// field from the next PATCH body. As above, the device's
+				// state only reflects that after a later refresh.
+				Config: testAccNetboxDevicePrimaryIPDeletedConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "primary_ipv4", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetboxDevicePrimaryIPDeletedConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name      = "eth0"
+  type      = "1000base-t"
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address          = "192.0.2.1/32"
+  interface_id        = netbox_device_interface.test.id
+}
+`, testName)
+}
+
+func testAccNetboxDevicePrimaryIPConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name      = "eth0"
+  type      = "1000base-t"
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address          = "192.0.2.1/32"
+  interface_id        = netbox_device_interface.test.id
+}
+
+resource "netbox_device_primary_ip" "test" {
+  device_id     = netbox_device.test.id
+  ip_address_id = netbox_ip_address.test.id
+  ip_family     = 4
+}
+`, testName)
+}
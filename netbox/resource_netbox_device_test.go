@@ -0,0 +1,136 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDevice_basic(t *testing.T) {
+	testSlug := "devicebasic"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConfig(testName, "active", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_device.test", "custom_fields.%", "0"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConfig(testName, "offline", `
+  description = "updated by acceptance test"
+  custom_fields = {
+    asset_tag = "abc123"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "status", "offline"),
+					resource.TestCheckResourceAttr("netbox_device.test", "description", "updated by acceptance test"),
+					resource.TestCheckResourceAttr("netbox_device.test", "custom_fields.asset_tag", "abc123"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDevice_clearPlatform(t *testing.T) {
+	testSlug := "deviceclearplatform"
+	testName := fmt.Sprintf("testacc-%s", testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceClearPlatformConfig(testName, `platform_id = netbox_platform.test.id`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device.test", "platform_id", "netbox_platform.test", "id"),
+				),
+			},
+			{
+				// Unsetting platform_id must actually clear it server-side,
+				// not just leave it out of the next PATCH body.
+				Config: testAccNetboxDeviceClearPlatformConfig(testName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "platform_id", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetboxDeviceClearPlatformConfig(testName, platformAttr string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+  status         = "active"
+  %[2]s
+}
+`, testName, platformAttr)
+}
+
+func testAccNetboxDeviceConfig(testName, status, extraAttrs string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name  = "%[1]s"
+  color = "000000"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+  status         = "%[2]s"
+%[3]s
+}
+`, testName, status, extraAttrs)
+}
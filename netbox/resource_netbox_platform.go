@@ -0,0 +1,134 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxPlatform() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxPlatformCreate,
+		ReadContext:   resourceNetboxPlatformRead,
+		UpdateContext: resourceNetboxPlatformUpdate,
+		DeleteContext: resourceNetboxPlatformDelete,
+
+		Description: `From the [official documentation](https://docs.netbox.dev/en/stable/core-functionality/devices/#platforms):
+
+> A platform defines the type of software running on a device or virtual machine. This can be helpful to model when it is necessary to distinguish between different versions or feature sets.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceNetboxPlatformCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	name := d.Get("name").(string)
+
+	slug := name
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := models.WritablePlatform{
+		Name: &name,
+		Slug: &slug,
+	}
+
+	params := dcim.NewDcimPlatformsCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimPlatformsCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPlatformRead(ctx, d, m)
+}
+
+func resourceNetboxPlatformRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPlatformsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPlatformsRead(params, nil)
+	if err != nil {
+		if readErr, ok := err.(*dcim.DcimPlatformsReadDefault); ok && readErr.Code() == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	platform := res.GetPayload()
+	d.Set("name", platform.Name)
+	d.Set("slug", platform.Slug)
+
+	return diags
+}
+
+func resourceNetboxPlatformUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	name := d.Get("name").(string)
+	slug := name
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := models.WritablePlatform{
+		Name: &name,
+		Slug: &slug,
+	}
+
+	params := dcim.NewDcimPlatformsUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimPlatformsUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxPlatformRead(ctx, d, m)
+}
+
+func resourceNetboxPlatformDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPlatformsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPlatformsDelete(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}